@@ -0,0 +1,39 @@
+package cron
+
+import "context"
+
+// JobContext is an alternative to Job for jobs that want to observe
+// cancellation: the context passed to Run is canceled as soon as Stop is
+// called, letting a cooperative job abort in-progress work instead of
+// running to completion after shutdown has begun.
+type JobContext interface {
+	Run(ctx context.Context)
+}
+
+// AddJobContext adds a JobContext to the Cron to be run on the given
+// schedule. cmd is invoked with a context derived from the Cron's run
+// context, which is canceled when Stop is called.
+func (c *Cron) AddJobContext(spec, name string, cmd JobContext) (EntryID, error) {
+	return c.AddJob(spec, name, c.wrapJobContext(cmd))
+}
+
+// wrapJobContext adapts a JobContext to the plain Job interface by looking
+// up the Cron's current run context at invocation time. c.ctx is read under
+// c.mu since a Stop followed by a Start can race a still-running job's read
+// against Start's write.
+func (c *Cron) wrapJobContext(cmd JobContext) Job {
+	return FuncJob(func() {
+		c.mu.RLock()
+		ctx := c.ctx
+		c.mu.RUnlock()
+		cmd.Run(ctx)
+	})
+}
+
+// closedContext returns a context.Context that is already Done, for Stop to
+// return when the Cron was not running.
+func closedContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
@@ -1,8 +1,7 @@
 package cron
 
 import (
-	"log"
-	"runtime"
+	"context"
 	"sort"
 	"sync"
 	"time"
@@ -14,14 +13,62 @@ import (
 type Cron struct {
 	mu sync.RWMutex
 	sync.Once
-	entries  map[string]*Entry
-	stop     chan struct{}
-	add      chan *Entry
-	update   chan *Entry
-	snapshot chan []*Entry
-	running  bool
-	ErrorLog *log.Logger
-	location *time.Location
+	entries   map[EntryID]*Entry
+	byName    map[string]EntryID
+	nextID    EntryID
+	stop      chan struct{}
+	add       chan *Entry
+	update    chan *Entry
+	remove    chan EntryID
+	snapshot  chan []*Entry
+	running   bool
+	logger    Logger
+	location  *time.Location
+	chain     Chain
+	parser    Parser
+	ctx       context.Context
+	cancel    context.CancelFunc
+	jobWaiter *sync.WaitGroup
+	userChain []JobWrapper
+}
+
+// EntryID identifies an entry within a Cron instance. It is assigned when
+// the entry is added and is stable across updates, letting callers hold on
+// to a handle instead of re-identifying an entry by name.
+type EntryID int
+
+// Option represents a modification to the default behavior of a Cron.
+type Option func(*Cron)
+
+// WithChain sets the JobWrapper chain applied to every entry added to the
+// Cron, wrapping each submitted Job before it is scheduled. Per-entry chains
+// can still be layered on top by calling chain.Then(job) before AddJob.
+//
+// WithChain composes with, rather than replaces, panic recovery: Recover is
+// always the outermost wrapper around every job, regardless of whether it
+// is listed here, so a panicking job can never crash the process.
+func WithChain(wrappers ...JobWrapper) Option {
+	return func(c *Cron) {
+		c.userChain = wrappers
+	}
+}
+
+// WithParser sets the Parser used by AddJob/UpdateJob to turn a spec string
+// into a Schedule, letting callers opt into a 6-field Quartz-style seconds
+// field, a 5-field POSIX crontab, or a descriptor-only parser instead of the
+// package default.
+func WithParser(p Parser) Option {
+	return func(c *Cron) {
+		c.parser = p
+	}
+}
+
+// WithLogger sets the Logger used to report Cron's lifecycle events (info
+// level) and panics/parse errors (error level). Defaults to DefaultLogger.
+func WithLogger(logger Logger) Option {
+	return func(c *Cron) {
+		c.logger = logger
+	}
 }
 
 // Job is an interface for submitted cron jobs.
@@ -38,6 +85,9 @@ type Schedule interface {
 
 // Entry consists of a schedule and the func to execute on that schedule.
 type Entry struct {
+	// ID is the cron-assigned opaque identifier for this entry.
+	ID EntryID
+
 	// The schedule on which this job should be run.
 	Schedule Schedule
 
@@ -52,6 +102,11 @@ type Entry struct {
 	// The Job's name
 	Name string
 
+	// Spec is the original crontab spec string this entry was parsed from.
+	// It is retained so the schedule can be reported back to callers (e.g.
+	// over an HTTP admin endpoint) without having to re-derive it.
+	Spec string
+
 	// The Job to run.
 	Job Job
 }
@@ -76,22 +131,33 @@ func (s byTime) Less(i, j int) bool {
 }
 
 // New returns a new Cron job runner, in the Local time zone.
-func New() *Cron {
-	return NewWithLocation(time.Now().Location())
+func New(opts ...Option) *Cron {
+	return NewWithLocation(time.Now().Location(), opts...)
 }
 
 // NewWithLocation returns a new Cron job runner.
-func NewWithLocation(location *time.Location) *Cron {
-	return &Cron{
-		entries:  make(map[string]*Entry),
+func NewWithLocation(location *time.Location, opts ...Option) *Cron {
+	c := &Cron{
+		entries:  make(map[EntryID]*Entry),
+		byName:   make(map[string]EntryID),
 		add:      make(chan *Entry),
 		update:   make(chan *Entry),
+		remove:   make(chan EntryID),
 		stop:     make(chan struct{}),
 		snapshot: make(chan []*Entry),
 		running:  false,
-		ErrorLog: nil,
+		logger:   DefaultLogger,
 		location: location,
+		parser:   standardParser,
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	// Recover is always the outermost wrapper so a panic anywhere in a
+	// user-supplied chain (or in the job itself) can never crash the
+	// process, whether or not the caller used WithChain.
+	c.chain = NewChain(append([]JobWrapper{Recover(c.logger)}, c.userChain...)...)
+	return c
 }
 
 // A wrapper that turns a func() into a cron.Job
@@ -100,7 +166,7 @@ type FuncJob func()
 func (f FuncJob) Run() { f() }
 
 // AddFunc adds a func to the Cron to be run on the given schedule.
-func (c *Cron) AddFunc(spec, name string, cmd func()) error {
+func (c *Cron) AddFunc(spec, name string, cmd func()) (EntryID, error) {
 	return c.AddJob(spec, name, FuncJob(cmd))
 }
 
@@ -109,62 +175,141 @@ func (c *Cron) UpdateFunc(spec, name string, cmd func()) error {
 	return c.UpdateJob(spec, name, FuncJob(cmd))
 }
 
-// AddJob adds a Job to the Cron to be run on the given schedule.
-func (c *Cron) AddJob(spec, name string, cmd Job) error {
-	schedule, err := Parse(spec)
+// AddJob adds a Job to the Cron to be run on the given schedule, parsed
+// using the Cron's configured Parser (the standard 5-field parser unless
+// WithParser was given), and returns the EntryID assigned to it.
+func (c *Cron) AddJob(spec, name string, cmd Job) (EntryID, error) {
+	schedule, err := c.parser.Parse(spec)
 	if err != nil {
-		return err
+		c.logger.Error(err, "cron: failed to parse spec", "spec", spec, "name", name)
+		return 0, err
 	}
-	c.Schedule(schedule, name, cmd, false)
-	return nil
+	return c.scheduleEntry(schedule, spec, name, cmd, false), nil
 }
 
 // UpdateJob update a Job to the Cron to be run on the given schedule by name.
 func (c *Cron) UpdateJob(spec, name string, cmd Job) error {
-	schedule, err := Parse(spec)
+	schedule, err := c.parser.Parse(spec)
 	if err != nil {
+		c.logger.Error(err, "cron: failed to parse spec", "spec", spec, "name", name)
 		return err
 	}
-	c.Schedule(schedule, name, cmd, true)
+	c.scheduleEntry(schedule, spec, name, cmd, true)
 	return nil
 }
 
-// RemoveJobOrFunc remove a job or func from the Cron to be run on the given schedule.
+// RemoveJobOrFunc removes a job or func from the Cron by name. It is a
+// convenience wrapper around Remove for callers that only kept the name
+// around instead of the EntryID returned from AddJob/AddFunc.
 func (c *Cron) RemoveJobOrFunc(name string) {
+	c.mu.RLock()
+	id, has := c.byName[name]
+	c.mu.RUnlock()
+	if !has {
+		return
+	}
+	c.Remove(id)
+}
+
+// Entry returns a copy of the entry with the given id, or the zero Entry if
+// it does not exist.
+func (c *Cron) Entry(id EntryID) Entry {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if e, ok := c.entries[id]; ok {
+		return *e
+	}
+	return Entry{}
+}
+
+// Remove removes the entry with the given id, if any. If the Cron is
+// running, the removal is routed through the run() loop via the remove
+// channel so the currently-armed timer is recomputed and cannot fire for a
+// deleted entry.
+func (c *Cron) Remove(id EntryID) {
+	c.init()
+	if c.running {
+		c.remove <- id
+		return
+	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	if c.entries != nil {
-		delete(c.entries, name)
+	c.removeEntry(id)
+}
+
+// removeEntry deletes the entry with the given id from both the entries map
+// and the name index. The caller must hold c.mu.
+func (c *Cron) removeEntry(id EntryID) {
+	if e, ok := c.entries[id]; ok {
+		delete(c.byName, e.Name)
 	}
-	return
+	delete(c.entries, id)
+}
+
+// Schedule adds a Job to the Cron to be run on the given schedule, returning
+// the EntryID assigned to it. Since schedule was not parsed from a spec
+// string, the resulting Entry's Spec field is left empty.
+func (c *Cron) Schedule(schedule Schedule, name string, cmd Job, update bool) EntryID {
+	return c.scheduleEntry(schedule, "", name, cmd, update)
 }
 
-// Schedule adds a Job to the Cron to be run on the given schedule.
-func (c *Cron) Schedule(schedule Schedule, name string, cmd Job, update bool) {
+// scheduleEntry is the shared implementation behind Schedule, AddJob and
+// UpdateJob; spec is the original crontab string, or "" if the caller built
+// its own Schedule.
+func (c *Cron) scheduleEntry(schedule Schedule, spec, name string, cmd Job, update bool) EntryID {
 	c.init()
 	entry := &Entry{
 		Schedule: schedule,
-		Job:      cmd,
+		Spec:     spec,
+		Job:      c.chain.Then(cmd),
 		Name:     name,
 	}
 	if !c.running {
 		c.mu.Lock()
 		defer c.mu.Unlock()
-		_, has := c.entries[name]
-		if !has {
-			c.entries[name] = entry
+		if id, has := c.byName[name]; has {
+			if update {
+				entry.ID = id
+				c.entries[id] = entry
+			}
+			return id
 		}
-		return
+		c.nextID++
+		entry.ID = c.nextID
+		c.entries[entry.ID] = entry
+		c.byName[name] = entry.ID
+		return entry.ID
 	}
 
 	if update {
 		c.mu.Lock()
-		defer c.mu.Unlock()
-		c.entries[name] = entry
+		id, has := c.byName[name]
+		if !has {
+			c.nextID++
+			id = c.nextID
+			c.byName[name] = id
+		}
+		entry.ID = id
+		c.entries[id] = entry
+		c.mu.Unlock()
 		c.update <- entry
-		return
+		return id
 	}
+
+	c.mu.Lock()
+	if id, has := c.byName[name]; has {
+		// A non-update add for a name that's already registered is a no-op:
+		// mirror the non-running branch and the add-channel handling in
+		// run(), which also drops it, instead of minting an EntryID that
+		// would never actually be stored.
+		c.mu.Unlock()
+		return id
+	}
+	c.nextID++
+	entry.ID = c.nextID
+	c.mu.Unlock()
 	c.add <- entry
+	return entry.ID
 }
 
 // Entries returns a snapshot of the cron entries.
@@ -189,6 +334,11 @@ func (c *Cron) Start() {
 		return
 	}
 	c.running = true
+	c.mu.Lock()
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	c.jobWaiter = &sync.WaitGroup{}
+	c.mu.Unlock()
+	c.logger.Info("cron: start")
 	go c.run()
 }
 
@@ -198,18 +348,23 @@ func (c *Cron) Run() {
 		return
 	}
 	c.running = true
+	c.mu.Lock()
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	c.jobWaiter = &sync.WaitGroup{}
+	c.mu.Unlock()
+	c.logger.Info("cron: start")
 	c.run()
 }
 
-func (c *Cron) runWithRecovery(j Job) {
-	defer func() {
-		if r := recover(); r != nil {
-			const size = 64 << 10
-			buf := make([]byte, size)
-			buf = buf[:runtime.Stack(buf, false)]
-			c.logf("cron: panic running job: %v\n%s", r, buf)
-		}
-	}()
+// runWithRecovery runs the given, already-wrapped job and signals wg once it
+// returns, so Stop can report when every in-flight run has drained. wg is
+// the jobWaiter captured by run() at the start of its current Start/Stop
+// cycle, not read fresh from c, so a subsequent Start does not hand this
+// call a WaitGroup it never called Add on. Panic recovery is handled by the
+// Recover JobWrapper applied via the Cron's chain at schedule time rather
+// than here.
+func (c *Cron) runWithRecovery(wg *sync.WaitGroup, j Job) {
+	defer wg.Done()
 	j.Run()
 }
 
@@ -217,6 +372,10 @@ func (c *Cron) runWithRecovery(j Job) {
 // access to the 'running' state variable.
 func (c *Cron) run() {
 	c.init()
+	c.mu.RLock()
+	wg := c.jobWaiter
+	c.mu.RUnlock()
+
 	// Figure out the next activation times for each entry.
 	now := c.now()
 	c.mu.RLock()
@@ -246,14 +405,18 @@ func (c *Cron) run() {
 			select {
 			case now = <-timer.C:
 				now = now.In(c.location)
+				c.logger.Info("cron: wake", "now", now)
 				// Run every entry whose next time was less than now
 				for _, e := range entries {
 					if e.Next.After(now) || e.Next.IsZero() {
 						break
 					}
-					go c.runWithRecovery(e.Job)
+					c.logger.Info("cron: run", "name", e.Name, "next", e.Next)
+					wg.Add(1)
+					go c.runWithRecovery(wg, e.Job)
 					e.Prev = e.Next
 					e.Next = e.Schedule.Next(now)
+					c.logger.Info("cron: next-run computed", "name", e.Name, "next", e.Next)
 				}
 
 			case newEntry := <-c.add:
@@ -261,19 +424,30 @@ func (c *Cron) run() {
 				now = c.now()
 				newEntry.Next = newEntry.Schedule.Next(now)
 				c.mu.Lock()
-				_, has := c.entries[newEntry.Name]
-				if !has {
-					c.entries[newEntry.Name] = newEntry
+				if _, has := c.byName[newEntry.Name]; !has {
+					c.entries[newEntry.ID] = newEntry
+					c.byName[newEntry.Name] = newEntry.ID
 				}
 				c.mu.Unlock()
+				c.logger.Info("cron: entry added", "name", newEntry.Name, "next", newEntry.Next)
 
 			case newEntry := <-c.update:
 				timer.Stop()
 				now = c.now()
 				newEntry.Next = newEntry.Schedule.Next(now)
 				c.mu.Lock()
-				c.entries[newEntry.Name] = newEntry
+				c.entries[newEntry.ID] = newEntry
+				c.byName[newEntry.Name] = newEntry.ID
+				c.mu.Unlock()
+				c.logger.Info("cron: entry updated", "name", newEntry.Name, "next", newEntry.Next)
+
+			case id := <-c.remove:
+				timer.Stop()
+				now = c.now()
+				c.mu.Lock()
+				c.removeEntry(id)
 				c.mu.Unlock()
+				c.logger.Info("cron: entry removed", "id", id)
 
 			case <-c.snapshot:
 				c.snapshot <- c.entrySnapshot()
@@ -281,6 +455,7 @@ func (c *Cron) run() {
 
 			case <-c.stop:
 				timer.Stop()
+				c.logger.Info("cron: stop")
 				return
 			}
 
@@ -289,22 +464,33 @@ func (c *Cron) run() {
 	}
 }
 
-// Logs an error to stderr or to the configured error log.
-func (c *Cron) logf(format string, args ...interface{}) {
-	if c.ErrorLog != nil {
-		c.ErrorLog.Printf(format, args...)
-	} else {
-		log.Printf(format, args...)
-	}
-}
-
 // Stop stops the cron scheduler if it is running; otherwise it does nothing.
-func (c *Cron) Stop() {
+// It cancels the context passed to any JobContext jobs, and returns a
+// context.Context that is Done once every job that was running at the time
+// of the call has returned, so callers can wait for in-flight work (e.g. to
+// flush state or close a DB connection) before exiting.
+func (c *Cron) Stop() context.Context {
 	if !c.running {
-		return
+		return closedContext()
 	}
 	c.stop <- struct{}{}
 	c.running = false
+
+	c.mu.RLock()
+	jobCancel := c.cancel
+	wg := c.jobWaiter
+	c.mu.RUnlock()
+	jobCancel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		// wg is this Start/Stop cycle's own WaitGroup, captured above rather
+		// than read fresh from c, so a subsequent Start (which swaps in a
+		// new one) can never race with this Wait.
+		wg.Wait()
+		cancel()
+	}()
+	return ctx
 }
 
 // entrySnapshot returns a copy of the current cron entry list.
@@ -314,9 +500,12 @@ func (c *Cron) entrySnapshot() []*Entry {
 	defer c.mu.RUnlock()
 	for _, e := range c.entries {
 		entries = append(entries, &Entry{
+			ID:       e.ID,
 			Schedule: e.Schedule,
 			Next:     e.Next,
 			Prev:     e.Prev,
+			Name:     e.Name,
+			Spec:     e.Spec,
 			Job:      e.Job,
 		})
 	}
@@ -334,7 +523,10 @@ func (c *Cron) init() {
 		c.mu.Lock()
 		defer c.mu.Unlock()
 		if c.entries == nil {
-			c.entries = make(map[string]*Entry)
+			c.entries = make(map[EntryID]*Entry)
+		}
+		if c.byName == nil {
+			c.byName = make(map[string]EntryID)
 		}
 	})
 }
@@ -0,0 +1,149 @@
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// noopJobContext is a minimal JobContext for tests that only care about
+// being scheduled and invoked, not about the context it receives.
+type noopJobContext struct{}
+
+func (noopJobContext) Run(ctx context.Context) {}
+
+// TestDuplicateNameAddWhileRunningReturnsExistingID reproduces the
+// regression from review: adding a job under a name that's already
+// registered, while the Cron is running, must return the existing EntryID
+// rather than minting a new one that's never actually stored.
+func TestDuplicateNameAddWhileRunningReturnsExistingID(t *testing.T) {
+	c := New(WithLogger(DiscardLogger))
+	c.Start()
+	defer c.Stop()
+
+	first, err := c.AddFunc("@every 1h", "dup", func() {})
+	if err != nil {
+		t.Fatalf("AddFunc: %v", err)
+	}
+
+	second, err := c.AddFunc("@every 1h", "dup", func() {})
+	if err != nil {
+		t.Fatalf("AddFunc: %v", err)
+	}
+
+	if second != first {
+		t.Fatalf("duplicate-name add while running returned a new EntryID %d, want the existing %d", second, first)
+	}
+
+	// The returned ID must actually resolve to a stored entry, not the zero
+	// Entry, and Remove must not be a silent no-op for it.
+	if e := c.Entry(second); e.Name != "dup" {
+		t.Fatalf("Entry(%d) = %+v, want the stored \"dup\" entry", second, e)
+	}
+
+	c.Remove(second)
+	// Remove routes through the run() loop's channel, so the removal may not
+	// be visible the instant Remove returns; poll briefly for it to land.
+	deadline := time.After(time.Second)
+	for {
+		if e := c.Entry(first); e.Name == "" {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatalf("entry still present a second after Remove")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+}
+
+// TestEntryRemoveRoundTrip adds, looks up and removes an entry while the
+// Cron is not running, exercising the non-running path through
+// scheduleEntry/removeEntry.
+func TestEntryRemoveRoundTrip(t *testing.T) {
+	c := New(WithLogger(DiscardLogger))
+
+	id, err := c.AddFunc("@every 1h", "job", func() {})
+	if err != nil {
+		t.Fatalf("AddFunc: %v", err)
+	}
+
+	e := c.Entry(id)
+	if e.Name != "job" {
+		t.Fatalf("Entry(%d).Name = %q, want %q", id, e.Name, "job")
+	}
+
+	c.Remove(id)
+	if e := c.Entry(id); e.Name != "" {
+		t.Fatalf("entry still present after Remove: %+v", e)
+	}
+}
+
+// TestStopWaitsForInFlightRun starts a Cron with a job that blocks until
+// released, triggers it, then asserts the context returned by Stop isn't
+// Done until the job finishes.
+func TestStopWaitsForInFlightRun(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	c := New(WithLogger(DiscardLogger))
+	if _, err := c.AddFunc("@every 10ms", "slow", func() {
+		close(started)
+		<-release
+	}); err != nil {
+		t.Fatalf("AddFunc: %v", err)
+	}
+
+	c.Start()
+
+	// Every rounds "@every 10ms" up to one second, so the first run can come
+	// up to a full second after Start.
+	select {
+	case <-started:
+	case <-time.After(2 * time.Second):
+		t.Fatal("job never started")
+	}
+
+	ctx := c.Stop()
+	select {
+	case <-ctx.Done():
+		t.Fatal("Stop's context was Done before the in-flight job finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Stop's context was never Done after the in-flight job finished")
+	}
+}
+
+// TestRestartCycling reproduces the regression from review: repeatedly
+// Stop()-ing and Start()-ing the same Cron while a job is ticking used to
+// reuse a single instance-lifetime jobWaiter across cycles, which panics
+// ("sync: WaitGroup is reused before previous Wait has returned") once a
+// job ticks after a Start that raced a prior Stop's drain goroutine. It
+// also exercises the c.ctx read/write that a JobContext job performs,
+// which must not race with Start's write across a restart (run with
+// -race to catch that).
+func TestRestartCycling(t *testing.T) {
+	c := New(WithLogger(DiscardLogger))
+	if _, err := c.AddJobContext("@every 1ms", "job", noopJobContext{}); err != nil {
+		t.Fatalf("AddJobContext: %v", err)
+	}
+	c.Schedule(ConstantDelaySchedule{Delay: time.Millisecond}, "ticker", FuncJob(func() {}), false)
+	// Every rounds "@every 1ms" up to one second, so the JobContext job above
+	// rarely runs within this test; schedule one directly so c.ctx is read on
+	// every tick.
+	c.Schedule(ConstantDelaySchedule{Delay: time.Millisecond}, "ctxticker", c.wrapJobContext(noopJobContext{}), false)
+
+	c.Start()
+	for i := 0; i < 50; i++ {
+		time.Sleep(time.Millisecond)
+		c.Stop()
+		c.Start()
+	}
+	c.Stop()
+}
@@ -0,0 +1,72 @@
+package cron
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+)
+
+// Logger is used by Cron and its built-in JobWrappers to report lifecycle
+// events and errors. Info receives routine events such as entries being
+// added or the scheduler waking up; Error receives panics recovered from
+// jobs and schedule parse failures. Implementations can adapt this to logr,
+// zap, zerolog, or any other structured logger.
+type Logger interface {
+	Info(msg string, keysAndValues ...interface{})
+	Error(err error, msg string, keysAndValues ...interface{})
+}
+
+// DefaultLogger logs Info and Error messages to stdout via the standard
+// library log package. It is used by a Cron unless overridden with
+// WithLogger. It is verbose by default so lifecycle events (entries added,
+// wake, run, next-run computed, start/stop) are visible out of the box; use
+// PrintfLogger or DiscardLogger to quiet Info-level logging.
+var DefaultLogger Logger = VerbosePrintfLogger(log.New(os.Stdout, "cron: ", log.LstdFlags))
+
+// DiscardLogger discards every message logged to it.
+var DiscardLogger Logger = PrintfLogger(log.New(ioutil.Discard, "", 0))
+
+// printfAdapter is satisfied by *log.Logger and similar Printf-style loggers.
+type printfAdapter interface {
+	Printf(string, ...interface{})
+}
+
+// printfLogger adapts a Printf-style logger to the Logger interface.
+type printfLogger struct {
+	logger  printfAdapter
+	verbose bool
+}
+
+// PrintfLogger wraps a Printf-style logger, such as *log.Logger, so that
+// Error messages are logged and Info messages are discarded.
+func PrintfLogger(l printfAdapter) Logger {
+	return printfLogger{logger: l}
+}
+
+// VerbosePrintfLogger wraps a Printf-style logger so both Info and Error
+// messages are logged.
+func VerbosePrintfLogger(l printfAdapter) Logger {
+	return printfLogger{logger: l, verbose: true}
+}
+
+func (p printfLogger) Info(msg string, keysAndValues ...interface{}) {
+	if p.verbose {
+		p.logger.Printf(formatMessage(msg, keysAndValues))
+	}
+}
+
+func (p printfLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	p.logger.Printf(formatMessage(msg, append(keysAndValues, "error", err)))
+}
+
+// formatMessage renders msg followed by its alternating key/value pairs.
+func formatMessage(msg string, keysAndValues []interface{}) string {
+	var b strings.Builder
+	b.WriteString(msg)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fmt.Fprintf(&b, ", %v=%v", keysAndValues[i], keysAndValues[i+1])
+	}
+	return b.String()
+}
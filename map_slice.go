@@ -1,6 +1,6 @@
 package cron
 
-func mapToSlice(entriesMap map[string]*Entry) (newEntries []*Entry) {
+func mapToSlice(entriesMap map[EntryID]*Entry) (newEntries []*Entry) {
 	newEntries = []*Entry{}
 	for _, entry := range entriesMap {
 		newEntries = append(newEntries, entry)
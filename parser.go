@@ -0,0 +1,344 @@
+package cron
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseOption configures the fields a Parser accepts, as a bitmask to be
+// combined with "|". For example, Minute|Hour|Dom|Month|Dow configures a
+// standard 5-field POSIX crontab, while Second|Minute|Hour|Dom|Month|Dow
+// adds a leading seconds field.
+type ParseOption int
+
+const (
+	Second         ParseOption = 1 << iota // Seconds field, default 0
+	SecondOptional                         // Optional seconds field, default 0
+	Minute                                 // Minutes field, default 0
+	Hour                                   // Hours field, default 0
+	Dom                                    // Day of month field, default *
+	Month                                  // Month field, default *
+	Dow                                    // Day of week field, default *
+	DowOptional                            // Optional day of week field, default *
+	Descriptor                             // Allow descriptors such as @monthly, @weekly and @every <duration>
+)
+
+// places lists the cron fields in the order they appear in a spec string.
+var places = []ParseOption{Second, Minute, Hour, Dom, Month, Dow}
+
+var fieldBounds = map[ParseOption]bounds{
+	Second: seconds,
+	Minute: minutes,
+	Hour:   hours,
+	Dom:    dom,
+	Month:  months,
+	Dow:    dow,
+}
+
+var defaultValue = map[ParseOption]uint64{
+	Second: 1 << seconds.min,
+	Minute: 1 << minutes.min,
+	Hour:   1 << hours.min,
+	Dom:    all(dom),
+	Month:  all(months),
+	Dow:    all(dow),
+}
+
+// Parser parses crontab specs into Schedules according to the set of fields
+// it was configured with via NewParser.
+type Parser struct {
+	options ParseOption
+}
+
+// NewParser creates a Parser configured with the given options. It panics
+// if more than one optional field is configured, since that would make the
+// field count ambiguous.
+func NewParser(options ParseOption) Parser {
+	optionals := 0
+	if options&SecondOptional > 0 {
+		options |= Second
+		optionals++
+	}
+	if options&DowOptional > 0 {
+		options |= Dow
+		optionals++
+	}
+	if optionals > 1 {
+		panic("cron: only one optional field may be configured")
+	}
+	return Parser{options: options}
+}
+
+// standardParser parses the traditional 5-field POSIX crontab format, plus
+// descriptors. It backs the package-level Parse function.
+var standardParser = NewParser(Minute | Hour | Dom | Month | Dow | Descriptor)
+
+// Parse parses a crontab spec using the standard 5-field format, with
+// support for descriptors such as "@hourly" and "@every 1h30m". For
+// non-standard field sets (e.g. a leading seconds field), construct a
+// Parser with NewParser and call its Parse method instead.
+func Parse(spec string) (Schedule, error) {
+	return standardParser.Parse(spec)
+}
+
+// Parse returns a new Schedule based on spec, a crontab expression with the
+// fields this Parser was configured to accept.
+func (p Parser) Parse(spec string) (Schedule, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("cron: empty spec string")
+	}
+	if spec[0] == '@' && p.options&Descriptor > 0 {
+		return parseDescriptor(spec)
+	}
+
+	max := 0
+	for _, place := range places {
+		if p.options&place > 0 {
+			max++
+		}
+	}
+	min := max
+	if p.options&(SecondOptional|DowOptional) > 0 {
+		min--
+	}
+
+	fields := strings.Fields(spec)
+	if count := len(fields); count < min || count > max {
+		return nil, fmt.Errorf("cron: expected %d to %d fields, found %d: %q", min, max, count, spec)
+	}
+
+	if min < max && len(fields) == min {
+		switch {
+		case p.options&SecondOptional > 0:
+			fields = append([]string{"0"}, fields...)
+		case p.options&DowOptional > 0:
+			fields = append(fields, "*")
+		}
+	}
+
+	schedule := &SpecSchedule{Location: time.Local}
+	field := 0
+	for _, place := range places {
+		if p.options&place == 0 {
+			setSpecField(schedule, place, defaultValue[place])
+			continue
+		}
+		value, err := getField(fields[field], fieldBounds[place])
+		if err != nil {
+			return nil, err
+		}
+		setSpecField(schedule, place, value)
+		field++
+	}
+
+	return schedule, nil
+}
+
+// setSpecField stores value into the SpecSchedule field named by place.
+func setSpecField(s *SpecSchedule, place ParseOption, value uint64) {
+	switch place {
+	case Second:
+		s.Second = value
+	case Minute:
+		s.Minute = value
+	case Hour:
+		s.Hour = value
+	case Dom:
+		s.Dom = value
+	case Month:
+		s.Month = value
+	case Dow:
+		s.Dow = value
+	}
+}
+
+// getField parses a single cron field, such as "*/5" or "1,2,15-20", into a
+// bitset of its matching values.
+func getField(field string, r bounds) (uint64, error) {
+	var bits uint64
+	for _, expr := range strings.Split(field, ",") {
+		bit, err := getRange(expr, r)
+		if err != nil {
+			return 0, err
+		}
+		bits |= bit
+	}
+	return bits, nil
+}
+
+// getRange parses a range expression of the form:
+//
+//	"*" | "?" | number | number "-" number
+//
+// optionally followed by "/" step, and returns the matching bits.
+func getRange(expr string, r bounds) (uint64, error) {
+	var (
+		start, end, step uint
+		extra            uint64
+		err              error
+	)
+
+	rangeAndStep := strings.SplitN(expr, "/", 2)
+	lowAndHigh := strings.SplitN(rangeAndStep[0], "-", 2)
+	singleDigit := len(lowAndHigh) == 1
+
+	if lowAndHigh[0] == "*" || lowAndHigh[0] == "?" {
+		start, end, extra = r.min, r.max, starBit
+	} else {
+		start, err = parseIntOrName(lowAndHigh[0], r.names)
+		if err != nil {
+			return 0, err
+		}
+		if len(lowAndHigh) == 2 {
+			end, err = parseIntOrName(lowAndHigh[1], r.names)
+			if err != nil {
+				return 0, err
+			}
+		} else {
+			end = start
+		}
+	}
+
+	switch len(rangeAndStep) {
+	case 1:
+		step = 1
+	case 2:
+		step, err = mustParseInt(rangeAndStep[1])
+		if err != nil {
+			return 0, err
+		}
+		if singleDigit {
+			end = r.max
+		}
+	}
+
+	if step == 0 {
+		return 0, fmt.Errorf("cron: step of range should be a positive number: %q", expr)
+	}
+	if start < r.min {
+		return 0, fmt.Errorf("cron: beginning of range (%d) below minimum (%d): %q", start, r.min, expr)
+	}
+	if end > r.max {
+		return 0, fmt.Errorf("cron: end of range (%d) above maximum (%d): %q", end, r.max, expr)
+	}
+	if start > end {
+		return 0, fmt.Errorf("cron: beginning of range (%d) beyond end of range (%d): %q", start, end, expr)
+	}
+
+	return getBits(start, end, step) | extra, nil
+}
+
+// parseIntOrName parses field as a named value (e.g. "mon") if r has names,
+// falling back to a plain integer.
+func parseIntOrName(field string, names map[string]uint) (uint, error) {
+	if names != nil {
+		if value, ok := names[strings.ToLower(field)]; ok {
+			return value, nil
+		}
+	}
+	return mustParseInt(field)
+}
+
+// mustParseInt parses expr as a non-negative integer.
+func mustParseInt(expr string) (uint, error) {
+	num, err := strconv.Atoi(expr)
+	if err != nil {
+		return 0, fmt.Errorf("cron: failed to parse int from %q: %s", expr, err)
+	}
+	if num < 0 {
+		return 0, fmt.Errorf("cron: negative number (%d) not allowed: %q", num, expr)
+	}
+	return uint(num), nil
+}
+
+// getBits returns the bits for every value in [min, max], stepping by step.
+func getBits(min, max, step uint) uint64 {
+	if step == 1 {
+		return ^(math.MaxUint64 << (max + 1)) & (math.MaxUint64 << min)
+	}
+	var bits uint64
+	for i := min; i <= max; i += step {
+		bits |= 1 << i
+	}
+	return bits
+}
+
+// all returns a bitset with every value of r set, plus the starBit flag.
+func all(r bounds) uint64 {
+	return getBits(r.min, r.max, 1) | starBit
+}
+
+// parseDescriptor returns the schedule described by a leading-"@" spec such
+// as "@daily" or "@every 1h30m".
+func parseDescriptor(descriptor string) (Schedule, error) {
+	switch descriptor {
+	case "@yearly", "@annually":
+		return &SpecSchedule{
+			Second:   1 << seconds.min,
+			Minute:   1 << minutes.min,
+			Hour:     1 << hours.min,
+			Dom:      1 << dom.min,
+			Month:    1 << months.min,
+			Dow:      all(dow),
+			Location: time.Local,
+		}, nil
+
+	case "@monthly":
+		return &SpecSchedule{
+			Second:   1 << seconds.min,
+			Minute:   1 << minutes.min,
+			Hour:     1 << hours.min,
+			Dom:      1 << dom.min,
+			Month:    all(months),
+			Dow:      all(dow),
+			Location: time.Local,
+		}, nil
+
+	case "@weekly":
+		return &SpecSchedule{
+			Second:   1 << seconds.min,
+			Minute:   1 << minutes.min,
+			Hour:     1 << hours.min,
+			Dom:      all(dom),
+			Month:    all(months),
+			Dow:      1 << dow.min,
+			Location: time.Local,
+		}, nil
+
+	case "@daily", "@midnight":
+		return &SpecSchedule{
+			Second:   1 << seconds.min,
+			Minute:   1 << minutes.min,
+			Hour:     1 << hours.min,
+			Dom:      all(dom),
+			Month:    all(months),
+			Dow:      all(dow),
+			Location: time.Local,
+		}, nil
+
+	case "@hourly":
+		return &SpecSchedule{
+			Second:   1 << seconds.min,
+			Minute:   1 << minutes.min,
+			Hour:     all(hours),
+			Dom:      all(dom),
+			Month:    all(months),
+			Dow:      all(dow),
+			Location: time.Local,
+		}, nil
+	}
+
+	const every = "@every "
+	if strings.HasPrefix(descriptor, every) {
+		duration, err := time.ParseDuration(descriptor[len(every):])
+		if err != nil {
+			return nil, fmt.Errorf("cron: failed to parse duration %q: %s", descriptor, err)
+		}
+		return Every(duration), nil
+	}
+
+	return nil, fmt.Errorf("cron: unrecognized descriptor: %q", descriptor)
+}
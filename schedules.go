@@ -0,0 +1,97 @@
+package cron
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// MarshalJSON renders an Entry's id, name, original spec, and prev/next
+// fire times, suitable for exposing over an HTTP admin endpoint. The Job
+// and Schedule fields are not serializable and are omitted.
+func (e Entry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ID   EntryID   `json:"id"`
+		Name string    `json:"name"`
+		Spec string    `json:"spec"`
+		Prev time.Time `json:"prev"`
+		Next time.Time `json:"next"`
+	}{
+		ID:   e.ID,
+		Name: e.Name,
+		Spec: e.Spec,
+		Prev: e.Prev,
+		Next: e.Next,
+	})
+}
+
+// ScheduledEntry is an Entry snapshot enriched with its next n upcoming fire
+// times, as returned by Cron.Schedules.
+type ScheduledEntry struct {
+	Entry
+	Upcoming []time.Time
+}
+
+// MarshalJSON renders the same fields as Entry.MarshalJSON, plus Upcoming.
+func (e ScheduledEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ID       EntryID     `json:"id"`
+		Name     string      `json:"name"`
+		Spec     string      `json:"spec"`
+		Prev     time.Time   `json:"prev"`
+		Next     time.Time   `json:"next"`
+		Upcoming []time.Time `json:"upcoming,omitempty"`
+	}{
+		ID:       e.ID,
+		Name:     e.Name,
+		Spec:     e.Spec,
+		Prev:     e.Prev,
+		Next:     e.Next,
+		Upcoming: e.Upcoming,
+	})
+}
+
+// Schedules returns a snapshot of the cron entries, each enriched with its
+// next n upcoming fire times computed iteratively from Entry.Next. This
+// mirrors Entries, but answers the common "when will each job next run"
+// question operators ask of an admin UI.
+func (c *Cron) Schedules(n int) []ScheduledEntry {
+	entries := c.Entries()
+	scheduled := make([]ScheduledEntry, len(entries))
+	for i, e := range entries {
+		scheduled[i] = ScheduledEntry{
+			Entry:    *e,
+			Upcoming: upcoming(e.Schedule, e.Next, n),
+		}
+	}
+	return scheduled
+}
+
+// upcoming returns up to n fire times of s, starting with (and including)
+// from. It stops early if the schedule becomes unsatisfiable.
+func upcoming(s Schedule, from time.Time, n int) []time.Time {
+	if from.IsZero() || n <= 0 {
+		return nil
+	}
+	times := make([]time.Time, 0, n)
+	t := from
+	for i := 0; i < n; i++ {
+		times = append(times, t)
+		t = s.Next(t)
+		if t.IsZero() {
+			break
+		}
+	}
+	return times
+}
+
+// NextScheduledTime parses spec with the standard parser and returns the
+// next time it would fire after from, without registering a job on any
+// Cron. For a custom field set (e.g. a leading seconds field), use a
+// Parser built with NewParser instead.
+func NextScheduledTime(spec string, from time.Time) (time.Time, error) {
+	schedule, err := Parse(spec)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(from), nil
+}
@@ -0,0 +1,143 @@
+package cron
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testLogger records Error calls so tests can assert a panic was reported
+// rather than crashing the process.
+type testLogger struct {
+	mu     sync.Mutex
+	errors []error
+}
+
+func (l *testLogger) Info(msg string, keysAndValues ...interface{}) {}
+
+func (l *testLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.errors = append(l.errors, err)
+}
+
+func (l *testLogger) errorCount() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.errors)
+}
+
+func TestRecoverCatchesPanic(t *testing.T) {
+	logger := &testLogger{}
+	job := Recover(logger)(FuncJob(func() {
+		panic(errors.New("boom"))
+	}))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		job.Run()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job.Run() did not return; panic was not recovered")
+	}
+
+	if got := logger.errorCount(); got != 1 {
+		t.Fatalf("expected 1 recovered panic to be logged, got %d", got)
+	}
+}
+
+// TestWithChainRecoversPanic reproduces the regression from review: a Cron
+// configured with WithChain(SkipIfStillRunning(...)), without explicitly
+// listing Recover, must still catch a panicking job rather than crashing.
+func TestWithChainRecoversPanic(t *testing.T) {
+	logger := &testLogger{}
+	c := New(WithLogger(logger), WithChain(SkipIfStillRunning(logger)))
+
+	id, err := c.AddFunc("@every 10ms", "panicky", func() {
+		panic(errors.New("boom"))
+	})
+	if err != nil {
+		t.Fatalf("AddFunc: %v", err)
+	}
+	_ = id
+
+	c.Start()
+	defer c.Stop()
+
+	// Every rounds "@every 10ms" up to one second, so the first run can come
+	// up to a full second after Start.
+	deadline := time.After(2 * time.Second)
+	for {
+		if logger.errorCount() > 0 {
+			return
+		}
+		select {
+		case <-deadline:
+			t.Fatal("panic from a WithChain-configured job was never recovered")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestSkipIfStillRunning(t *testing.T) {
+	logger := &testLogger{}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var runs int32
+	var mu sync.Mutex
+
+	job := SkipIfStillRunning(logger)(FuncJob(func() {
+		mu.Lock()
+		runs++
+		mu.Unlock()
+		started <- struct{}{}
+		<-release
+	}))
+
+	go job.Run()
+	<-started
+
+	// A second invocation while the first is still running must be skipped,
+	// not block or run concurrently.
+	job.Run()
+
+	mu.Lock()
+	got := runs
+	mu.Unlock()
+	if got != 1 {
+		t.Fatalf("expected second invocation to be skipped, got %d runs", got)
+	}
+	close(release)
+}
+
+func TestDelayIfStillRunning(t *testing.T) {
+	logger := &testLogger{}
+	var mu sync.Mutex
+	var order []int
+
+	job := DelayIfStillRunning(logger)(FuncJob(func() {
+		mu.Lock()
+		order = append(order, len(order))
+		mu.Unlock()
+	}))
+
+	first := make(chan struct{})
+	go func() {
+		job.Run()
+		close(first)
+	}()
+	<-first
+
+	job.Run()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(order) != 2 {
+		t.Fatalf("expected both invocations to eventually run, got %d", len(order))
+	}
+}